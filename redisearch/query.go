@@ -0,0 +1,98 @@
+package redisearch
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Query holds the parameters for a search request, built up incrementally via its setter methods.
+type Query struct {
+	Raw    string
+	Params map[string]interface{}
+}
+
+// VectorScoreField returns the name of the document attribute holding the distance of a match
+// from the query vector for the given VectorField, e.g. "__vec_score"
+func VectorScoreField(field string) string {
+	return "__" + field + "_score"
+}
+
+// NewQuery creates a new query with the given raw query string
+func NewQuery(raw string) *Query {
+	return &Query{
+		Raw:    raw,
+		Params: map[string]interface{}{},
+	}
+}
+
+// SetVectorQuery rewrites the query into a KNN vector-search clause, pre-filtered by the
+// query's current Raw contents, and binds blob/params for the search request.
+//
+//	q.SetVectorQuery("vec", 10, blob, map[string]interface{}{"EF_RUNTIME": 50})
+//
+// produces a raw query of the form `<filter>=>[KNN $K @vec $BLOB EF_RUNTIME $ef_runtime]`.
+func (q *Query) SetVectorQuery(field string, k int, blob []byte, params map[string]interface{}) *Query {
+	filter := q.Raw
+	if filter == "" {
+		filter = "*"
+	}
+
+	if q.Params == nil {
+		q.Params = map[string]interface{}{}
+	}
+	q.Params["K"] = k
+	q.Params["BLOB"] = blob
+
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	clause := fmt.Sprintf("=>[KNN $K @%s $BLOB", field)
+	for _, name := range names {
+		paramName := paramNameFor(name)
+		q.Params[paramName] = params[name]
+		clause += fmt.Sprintf(" %s $%s", name, paramName)
+	}
+	clause += "]"
+
+	q.Raw = filter + clause
+	return q
+}
+
+// GeoUnit is the distance unit used by a geo filter built with GeoFilter
+type GeoUnit string
+
+const (
+	// GeoUnitMeters measures radius in meters
+	GeoUnitMeters GeoUnit = "m"
+
+	// GeoUnitKilometers measures radius in kilometers
+	GeoUnitKilometers GeoUnit = "km"
+
+	// GeoUnitMiles measures radius in miles
+	GeoUnitMiles GeoUnit = "mi"
+
+	// GeoUnitFeet measures radius in feet
+	GeoUnitFeet GeoUnit = "ft"
+)
+
+// GeoFilter builds a query predicate matching documents whose GeoField value lies within radius
+// units of the given longitude/latitude, e.g. `@field:[lon lat radius unit]`.
+func GeoFilter(field string, lon, lat, radius float64, unit GeoUnit) string {
+	return fmt.Sprintf("@%s:[%g %g %g %s]", field, lon, lat, radius, unit)
+}
+
+// IsMissing builds a query predicate matching documents that lack the given field entirely. The
+// field must have been indexed with IndexMissing set for this predicate to match anything.
+func IsMissing(field string) string {
+	return fmt.Sprintf("ismissing(@%s)", field)
+}
+
+// paramNameFor derives the bound parameter name for a KNN runtime attribute, e.g.
+// "EF_RUNTIME" -> "ef_runtime"
+func paramNameFor(attr string) string {
+	return strings.ToLower(attr)
+}
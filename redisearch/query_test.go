@@ -0,0 +1,83 @@
+package redisearch
+
+import "testing"
+
+func TestSetVectorQuery(t *testing.T) {
+	blob := []byte{1, 2, 3, 4}
+
+	q := NewQuery("@title:hello")
+	q.SetVectorQuery("vec", 10, blob, map[string]interface{}{
+		"EF_RUNTIME": 50,
+		"EPSILON":    0.1,
+	})
+
+	wantRaw := "@title:hello=>[KNN $K @vec $BLOB EF_RUNTIME $ef_runtime EPSILON $epsilon]"
+	if q.Raw != wantRaw {
+		t.Errorf("Raw = %q, want %q", q.Raw, wantRaw)
+	}
+
+	if q.Params["K"] != 10 {
+		t.Errorf("Params[K] = %v, want 10", q.Params["K"])
+	}
+	if bv, ok := q.Params["BLOB"].([]byte); !ok || string(bv) != string(blob) {
+		t.Errorf("Params[BLOB] = %v, want %v", q.Params["BLOB"], blob)
+	}
+	if q.Params["ef_runtime"] != 50 {
+		t.Errorf("Params[ef_runtime] = %v, want 50", q.Params["ef_runtime"])
+	}
+	if q.Params["epsilon"] != 0.1 {
+		t.Errorf("Params[epsilon] = %v, want 0.1", q.Params["epsilon"])
+	}
+}
+
+func TestSetVectorQueryDefaultsFilterToMatchAll(t *testing.T) {
+	q := NewQuery("")
+	q.SetVectorQuery("vec", 5, []byte{0}, nil)
+
+	want := "*=>[KNN $K @vec $BLOB]"
+	if q.Raw != want {
+		t.Errorf("Raw = %q, want %q", q.Raw, want)
+	}
+}
+
+func TestSetVectorQueryDeterministicOrder(t *testing.T) {
+	params := map[string]interface{}{
+		"EF_RUNTIME": 50,
+		"EPSILON":    0.1,
+	}
+
+	first := NewQuery("*")
+	first.SetVectorQuery("vec", 10, []byte{1}, params)
+
+	for i := 0; i < 10; i++ {
+		q := NewQuery("*")
+		q.SetVectorQuery("vec", 10, []byte{1}, params)
+		if q.Raw != first.Raw {
+			t.Fatalf("Raw is non-deterministic: got %q, first was %q", q.Raw, first.Raw)
+		}
+	}
+}
+
+func TestGeoFilter(t *testing.T) {
+	got := GeoFilter("location", -122.4, 37.8, 10, GeoUnitKilometers)
+	want := "@location:[-122.4 37.8 10 km]"
+	if got != want {
+		t.Errorf("GeoFilter() = %q, want %q", got, want)
+	}
+}
+
+func TestIsMissing(t *testing.T) {
+	got := IsMissing("bio")
+	want := "ismissing(@bio)"
+	if got != want {
+		t.Errorf("IsMissing() = %q, want %q", got, want)
+	}
+}
+
+func TestVectorScoreField(t *testing.T) {
+	got := VectorScoreField("vec")
+	want := "__vec_score"
+	if got != want {
+		t.Errorf("VectorScoreField() = %q, want %q", got, want)
+	}
+}
@@ -1,5 +1,7 @@
 package redisearch
 
+import "fmt"
+
 // FieldType is an enumeration of field/property types
 type FieldType int
 
@@ -30,6 +32,34 @@ type Options struct {
 	// If the list is nil the default stop-words list is used.
 	// See https://oss.redislabs.com/redisearch/Stopwords.html#default_stop-word_list
 	Stopwords []string
+
+	// On selects the storage model backing the index: HashDataType (the default) or JSONDataType.
+	// When JSONDataType is used, each Field.Name is a JSONPath, typically combined with Field.As.
+	On IndexDataType
+
+	// Prefix restricts indexing to keys starting with one of the given prefixes. If empty, all
+	// keys of the selected On data type are indexed.
+	Prefix []string
+
+	// Filter is an FT expression further restricting which keys are indexed.
+	Filter string
+
+	// Language is the default language used for stemming, applied to documents that don't set
+	// LanguageField.
+	Language string
+
+	// LanguageField is the name of a document attribute holding a per-document language override.
+	LanguageField string
+
+	// Score is the default document ranking score, applied to documents that don't set ScoreField.
+	Score float64
+
+	// ScoreField is the name of a document attribute holding a per-document score override.
+	ScoreField string
+
+	// PayloadField is the name of a document attribute holding an opaque payload returned
+	// alongside search results, without being indexed.
+	PayloadField string
 }
 
 // DefaultOptions represents the default options
@@ -39,8 +69,20 @@ var DefaultOptions = Options{
 	NoFrequencies:   false,
 	NoOffsetVectors: false,
 	Stopwords:       nil,
+	On:              HashDataType,
 }
 
+// IndexDataType selects the storage model an index is built on
+type IndexDataType string
+
+const (
+	// HashDataType indexes Redis hashes
+	HashDataType IndexDataType = "HASH"
+
+	// JSONDataType indexes RedisJSON documents
+	JSONDataType IndexDataType = "JSON"
+)
+
 const (
 	// TextField full-text field
 	TextField FieldType = iota
@@ -53,14 +95,113 @@ const (
 
 	// TagField is a field used for compact indexing of comma separated values
 	TagField
+
+	// VectorField is a field used for indexing vector embeddings for KNN search
+	VectorField
+)
+
+// VectorAlgorithm is the KNN algorithm used to index a VectorField
+type VectorAlgorithm string
+
+const (
+	// FlatVectorAlgorithm is a brute-force flat index
+	FlatVectorAlgorithm VectorAlgorithm = "FLAT"
+
+	// HNSWVectorAlgorithm is a Hierarchical Navigable Small World graph index
+	HNSWVectorAlgorithm VectorAlgorithm = "HNSW"
+)
+
+// IsValid returns true if a is one of the algorithms supported by RediSearch
+func (a VectorAlgorithm) IsValid() bool {
+	switch a {
+	case FlatVectorAlgorithm, HNSWVectorAlgorithm:
+		return true
+	}
+	return false
+}
+
+// VectorFieldDataType is the data type of the vector blob stored in a VectorField
+type VectorFieldDataType string
+
+const (
+	// Float32VectorType stores the vector as 32-bit floats
+	Float32VectorType VectorFieldDataType = "FLOAT32"
+
+	// Float64VectorType stores the vector as 64-bit floats
+	Float64VectorType VectorFieldDataType = "FLOAT64"
 )
 
+// IsValid returns true if t is one of the data types supported by RediSearch
+func (t VectorFieldDataType) IsValid() bool {
+	switch t {
+	case Float32VectorType, Float64VectorType:
+		return true
+	}
+	return false
+}
+
+// VectorDistanceMetric is the distance metric used to score vectors against each other
+type VectorDistanceMetric string
+
+const (
+	// L2Metric is the Euclidean distance metric
+	L2Metric VectorDistanceMetric = "L2"
+
+	// IPMetric is the inner product distance metric
+	IPMetric VectorDistanceMetric = "IP"
+
+	// CosineMetric is the cosine distance metric
+	CosineMetric VectorDistanceMetric = "COSINE"
+)
+
+// IsValid returns true if m is one of the distance metrics supported by RediSearch
+func (m VectorDistanceMetric) IsValid() bool {
+	switch m {
+	case L2Metric, IPMetric, CosineMetric:
+		return true
+	}
+	return false
+}
+
 // Field represents a single field's Schema
 type Field struct {
 	Name     string
 	Type     FieldType
 	Sortable bool
 	Options  interface{}
+
+	// As is an optional alias the field is indexed and queried under, instead of Name. This is
+	// required when Name is a document attribute path (e.g. a JSONPath such as
+	// "$.user.first_name") that is not itself a valid query identifier.
+	As string
+}
+
+// PhoneticMatcher selects the phonetic algorithm and language used for phonetic matching on a
+// TextField, e.g. "dm:en" for the Double Metaphone algorithm applied to English.
+type PhoneticMatcher string
+
+const (
+	// PhoneticDoubleMetaphoneEnglish matches similarly-sounding English terms
+	PhoneticDoubleMetaphoneEnglish PhoneticMatcher = "dm:en"
+
+	// PhoneticDoubleMetaphoneFrench matches similarly-sounding French terms
+	PhoneticDoubleMetaphoneFrench PhoneticMatcher = "dm:fr"
+
+	// PhoneticDoubleMetaphonePortuguese matches similarly-sounding Portuguese terms
+	PhoneticDoubleMetaphonePortuguese PhoneticMatcher = "dm:pt"
+
+	// PhoneticDoubleMetaphoneSpanish matches similarly-sounding Spanish terms
+	PhoneticDoubleMetaphoneSpanish PhoneticMatcher = "dm:es"
+)
+
+// IsValid returns true if m is one of the matchers supported by RediSearch
+func (m PhoneticMatcher) IsValid() bool {
+	switch m {
+	case PhoneticDoubleMetaphoneEnglish, PhoneticDoubleMetaphoneFrench,
+		PhoneticDoubleMetaphonePortuguese, PhoneticDoubleMetaphoneSpanish:
+		return true
+	}
+	return false
 }
 
 // TextFieldOptions Options for text fields - weight and stemming enabled/disabled.
@@ -69,6 +210,18 @@ type TextFieldOptions struct {
 	Sortable bool
 	NoStem   bool
 	NoIndex  bool
+
+	// PhoneticMatcher enables phonetic matching using the given matcher, e.g. PhoneticDoubleMetaphoneEnglish.
+	// Leave empty to disable phonetic matching.
+	PhoneticMatcher PhoneticMatcher
+
+	// WithSuffixTrie builds a suffix trie for the field, enabling efficient contains (*foo*) and
+	// suffix (*foo) matching at the cost of extra index size.
+	WithSuffixTrie bool
+
+	// IndexMissing allows documents lacking this field to be matched via the ismissing(@field)
+	// query operator (see IsMissing).
+	IndexMissing bool
 }
 
 // TagFieldOptions options for indexing tag fields
@@ -77,6 +230,14 @@ type TagFieldOptions struct {
 	Separator byte
 	NoIndex   bool
 	Sortable  bool
+
+	// WithSuffixTrie builds a suffix trie for the field, enabling efficient contains (*foo*) and
+	// suffix (*foo) matching at the cost of extra index size.
+	WithSuffixTrie bool
+
+	// IndexMissing allows documents lacking this field to be matched via the ismissing(@field)
+	// query operator (see IsMissing).
+	IndexMissing bool
 }
 
 // NumericFieldOptions Options for numeric fields
@@ -85,6 +246,52 @@ type NumericFieldOptions struct {
 	NoIndex  bool
 }
 
+// GeoFieldOptions Options for geo fields
+type GeoFieldOptions struct {
+	Sortable bool
+	NoIndex  bool
+}
+
+// VectorFieldOptions are options for indexing vector embeddings, to be used with VectorField.
+//
+// Algorithm, Type, Dim and DistanceMetric are mandatory for every algorithm. InitialCap applies to
+// both algorithms, BlockSize only applies to FlatVectorAlgorithm, while M, EFConstruction,
+// EFRuntime and Epsilon only apply to HNSWVectorAlgorithm.
+type VectorFieldOptions struct {
+	Algorithm      VectorAlgorithm
+	Type           VectorFieldDataType
+	Dim            int
+	DistanceMetric VectorDistanceMetric
+
+	// InitialCap is the initial vector capacity of the index (FLAT and HNSW)
+	InitialCap int
+
+	// BlockSize is the size of a block in the index (FLAT only)
+	BlockSize int
+
+	// M is the maximum number of outgoing edges for each node in a graph layer (HNSW only)
+	M int
+
+	// EFConstruction is the number of neighbors to examine during graph construction (HNSW only)
+	EFConstruction int
+
+	// EFRuntime is the number of neighbors to examine during a query (HNSW only)
+	EFRuntime int
+
+	// Epsilon is the relative factor that sets the boundaries in which a range query may search
+	// for candidates (HNSW only)
+	Epsilon float64
+}
+
+// NewVectorField creates a new vector field with the given options
+func NewVectorField(name string, opts VectorFieldOptions) Field {
+	return Field{
+		Name:    name,
+		Type:    VectorField,
+		Options: opts,
+	}
+}
+
 // NewTextField creates a new text field with the given weight
 func NewTextField(name string) Field {
 	return Field{
@@ -152,6 +359,21 @@ func NewSortableNumericField(name string) Field {
 	return f
 }
 
+// NewGeoField creates a new geo field with the given name
+func NewGeoField(name string) Field {
+	return Field{
+		Name: name,
+		Type: GeoField,
+	}
+}
+
+// NewGeoFieldOptions creates a new geo field with the given options
+func NewGeoFieldOptions(name string, opts GeoFieldOptions) Field {
+	f := NewGeoField(name)
+	f.Options = opts
+	return f
+}
+
 // Schema represents an index schema Schema, or how the index would
 // treat documents sent to it.
 type Schema struct {
@@ -162,7 +384,8 @@ type Schema struct {
 // NewSchema creates a new Schema object
 func NewSchema(opts Options) *Schema {
 	return &Schema{
-		Fields: []Field{},
+		Fields:  []Field{},
+		Options: opts,
 	}
 }
 
@@ -174,3 +397,222 @@ func (m *Schema) AddField(f Field) *Schema {
 	m.Fields = append(m.Fields, f)
 	return m
 }
+
+// Serialize serializes the FT.CREATE SCHEMA arguments for a single field
+func (f Field) Serialize() ([]interface{}, error) {
+	args := []interface{}{f.Name}
+	if f.As != "" {
+		args = append(args, "AS", f.As)
+	}
+	args = append(args, typeToString(f.Type))
+
+	switch f.Type {
+	case VectorField:
+		opts, ok := f.Options.(VectorFieldOptions)
+		if !ok {
+			return nil, fmt.Errorf("redisearch: field %q is a VectorField but has no VectorFieldOptions", f.Name)
+		}
+		if !opts.Algorithm.IsValid() {
+			return nil, fmt.Errorf("redisearch: field %q has unknown vector algorithm %q", f.Name, opts.Algorithm)
+		}
+		if !opts.Type.IsValid() {
+			return nil, fmt.Errorf("redisearch: field %q has unknown vector data type %q", f.Name, opts.Type)
+		}
+		if !opts.DistanceMetric.IsValid() {
+			return nil, fmt.Errorf("redisearch: field %q has unknown vector distance metric %q", f.Name, opts.DistanceMetric)
+		}
+		if opts.Dim <= 0 {
+			return nil, fmt.Errorf("redisearch: field %q must have Dim > 0", f.Name)
+		}
+		attrs := vectorFieldAttrs(opts)
+		args = append(args, string(opts.Algorithm), len(attrs))
+		args = append(args, attrs...)
+	case TextField:
+		if opts, ok := f.Options.(TextFieldOptions); ok {
+			if opts.WithSuffixTrie {
+				args = append(args, "WITHSUFFIXTRIE")
+			}
+			if opts.NoStem {
+				args = append(args, "NOSTEM")
+			}
+			if opts.Weight != 0 {
+				args = append(args, "WEIGHT", opts.Weight)
+			}
+			if opts.PhoneticMatcher != "" {
+				if !opts.PhoneticMatcher.IsValid() {
+					return nil, fmt.Errorf("redisearch: unknown phonetic matcher %q", opts.PhoneticMatcher)
+				}
+				args = append(args, "PHONETIC", string(opts.PhoneticMatcher))
+			}
+			if opts.Sortable {
+				args = append(args, "SORTABLE")
+			}
+			if opts.NoIndex {
+				args = append(args, "NOINDEX")
+			}
+			if opts.IndexMissing {
+				args = append(args, "INDEXMISSING")
+			}
+		}
+	case NumericField:
+		if opts, ok := f.Options.(NumericFieldOptions); ok {
+			if opts.Sortable {
+				args = append(args, "SORTABLE")
+			}
+			if opts.NoIndex {
+				args = append(args, "NOINDEX")
+			}
+		}
+	case TagField:
+		if opts, ok := f.Options.(TagFieldOptions); ok {
+			if opts.WithSuffixTrie {
+				args = append(args, "WITHSUFFIXTRIE")
+			}
+			if opts.Separator != 0 && opts.Separator != ',' {
+				args = append(args, "SEPARATOR", string(opts.Separator))
+			}
+			if opts.Sortable {
+				args = append(args, "SORTABLE")
+			}
+			if opts.NoIndex {
+				args = append(args, "NOINDEX")
+			}
+			if opts.IndexMissing {
+				args = append(args, "INDEXMISSING")
+			}
+		}
+	case GeoField:
+		if opts, ok := f.Options.(GeoFieldOptions); ok {
+			if opts.Sortable {
+				args = append(args, "SORTABLE")
+			}
+			if opts.NoIndex {
+				args = append(args, "NOINDEX")
+			}
+		}
+	}
+
+	return args, nil
+}
+
+// typeToString maps a FieldType to its FT.CREATE SCHEMA type token
+func typeToString(t FieldType) string {
+	switch t {
+	case TextField:
+		return "TEXT"
+	case NumericField:
+		return "NUMERIC"
+	case GeoField:
+		return "GEO"
+	case TagField:
+		return "TAG"
+	case VectorField:
+		return "VECTOR"
+	}
+	return ""
+}
+
+// vectorFieldAttrs builds the flat list of attribute/value pairs following the
+// algorithm name in a VECTOR field definition
+func vectorFieldAttrs(opts VectorFieldOptions) []interface{} {
+	attrs := []interface{}{
+		"TYPE", string(opts.Type),
+		"DIM", opts.Dim,
+		"DISTANCE_METRIC", string(opts.DistanceMetric),
+	}
+	if opts.InitialCap > 0 {
+		attrs = append(attrs, "INITIAL_CAP", opts.InitialCap)
+	}
+
+	switch opts.Algorithm {
+	case FlatVectorAlgorithm:
+		if opts.BlockSize > 0 {
+			attrs = append(attrs, "BLOCK_SIZE", opts.BlockSize)
+		}
+	case HNSWVectorAlgorithm:
+		if opts.M > 0 {
+			attrs = append(attrs, "M", opts.M)
+		}
+		if opts.EFConstruction > 0 {
+			attrs = append(attrs, "EF_CONSTRUCTION", opts.EFConstruction)
+		}
+		if opts.EFRuntime > 0 {
+			attrs = append(attrs, "EF_RUNTIME", opts.EFRuntime)
+		}
+		if opts.Epsilon > 0 {
+			attrs = append(attrs, "EPSILON", opts.Epsilon)
+		}
+	}
+
+	return attrs
+}
+
+// Serialize serializes the full FT.CREATE arguments for the schema: the index-level ON/PREFIX/
+// FILTER/LANGUAGE/SCORE/PAYLOAD_FIELD directives followed by the SCHEMA field definitions.
+func (m *Schema) Serialize() ([]interface{}, error) {
+	args := []interface{}{}
+
+	on := m.Options.On
+	if on == "" {
+		on = HashDataType
+	}
+	args = append(args, "ON", string(on))
+
+	if len(m.Options.Prefix) > 0 {
+		args = append(args, "PREFIX", len(m.Options.Prefix))
+		for _, p := range m.Options.Prefix {
+			args = append(args, p)
+		}
+	}
+
+	if m.Options.Filter != "" {
+		args = append(args, "FILTER", m.Options.Filter)
+	}
+
+	if m.Options.Language != "" {
+		args = append(args, "LANGUAGE", m.Options.Language)
+	}
+	if m.Options.LanguageField != "" {
+		args = append(args, "LANGUAGE_FIELD", m.Options.LanguageField)
+	}
+
+	if m.Options.Score != 0 {
+		args = append(args, "SCORE", m.Options.Score)
+	}
+	if m.Options.ScoreField != "" {
+		args = append(args, "SCORE_FIELD", m.Options.ScoreField)
+	}
+
+	if m.Options.PayloadField != "" {
+		args = append(args, "PAYLOAD_FIELD", m.Options.PayloadField)
+	}
+
+	if m.Options.NoFieldFlags {
+		args = append(args, "NOFIELDS")
+	}
+	if m.Options.NoFrequencies {
+		args = append(args, "NOFREQS")
+	}
+	if m.Options.NoOffsetVectors {
+		args = append(args, "NOOFFSETS")
+	}
+	if len(m.Options.Stopwords) > 0 {
+		args = append(args, "STOPWORDS", len(m.Options.Stopwords))
+		for _, w := range m.Options.Stopwords {
+			args = append(args, w)
+		}
+	}
+
+	// NoSave is not an FT.CREATE flag: it is passed per-document on the indexing call, not here.
+
+	args = append(args, "SCHEMA")
+	for _, f := range m.Fields {
+		fieldArgs, err := f.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, fieldArgs...)
+	}
+
+	return args, nil
+}
@@ -0,0 +1,203 @@
+package redisearch
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFieldSerialize(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   Field
+		want    []interface{}
+		wantErr bool
+	}{
+		{
+			name:  "text field with no options",
+			field: NewTextField("title"),
+			want:  []interface{}{"title", "TEXT"},
+		},
+		{
+			name:  "sortable text field",
+			field: NewSortableTextField("title", 2),
+			want:  []interface{}{"title", "TEXT", "WEIGHT", float32(2), "SORTABLE"},
+		},
+		{
+			name: "text field with phonetic matcher",
+			field: NewTextFieldOptions("name", TextFieldOptions{
+				PhoneticMatcher: PhoneticDoubleMetaphoneEnglish,
+			}),
+			want: []interface{}{"name", "TEXT", "PHONETIC", "dm:en"},
+		},
+		{
+			name: "text field with unknown phonetic matcher",
+			field: NewTextFieldOptions("name", TextFieldOptions{
+				PhoneticMatcher: PhoneticMatcher("dm:xx"),
+			}),
+			wantErr: true,
+		},
+		{
+			name: "text field with alias",
+			field: func() Field {
+				f := NewTextField("$.user.first_name")
+				f.As = "first_name"
+				return f
+			}(),
+			want: []interface{}{"$.user.first_name", "AS", "first_name", "TEXT"},
+		},
+		{
+			name: "tag field with suffix trie and index missing",
+			field: NewTagFieldOptions("tags", TagFieldOptions{
+				WithSuffixTrie: true,
+				IndexMissing:   true,
+			}),
+			want: []interface{}{"tags", "TAG", "WITHSUFFIXTRIE", "INDEXMISSING"},
+		},
+		{
+			name:  "geo field with no options",
+			field: NewGeoField("location"),
+			want:  []interface{}{"location", "GEO"},
+		},
+		{
+			name: "geo field sortable and noindex",
+			field: NewGeoFieldOptions("location", GeoFieldOptions{
+				Sortable: true,
+				NoIndex:  true,
+			}),
+			want: []interface{}{"location", "GEO", "SORTABLE", "NOINDEX"},
+		},
+		{
+			name: "flat vector field",
+			field: NewVectorField("vec", VectorFieldOptions{
+				Algorithm:      FlatVectorAlgorithm,
+				Type:           Float32VectorType,
+				Dim:            128,
+				DistanceMetric: L2Metric,
+				BlockSize:      100,
+			}),
+			want: []interface{}{
+				"vec", "VECTOR", "FLAT", 8,
+				"TYPE", "FLOAT32", "DIM", 128, "DISTANCE_METRIC", "L2", "BLOCK_SIZE", 100,
+			},
+		},
+		{
+			name: "hnsw vector field",
+			field: NewVectorField("vec", VectorFieldOptions{
+				Algorithm:      HNSWVectorAlgorithm,
+				Type:           Float64VectorType,
+				Dim:            64,
+				DistanceMetric: CosineMetric,
+				M:              16,
+			}),
+			want: []interface{}{
+				"vec", "VECTOR", "HNSW", 8,
+				"TYPE", "FLOAT64", "DIM", 64, "DISTANCE_METRIC", "COSINE", "M", 16,
+			},
+		},
+		{
+			name: "vector field missing options",
+			field: Field{
+				Name: "vec",
+				Type: VectorField,
+			},
+			wantErr: true,
+		},
+		{
+			name: "vector field with unknown algorithm",
+			field: Field{
+				Name: "vec",
+				Type: VectorField,
+				Options: VectorFieldOptions{
+					Algorithm:      VectorAlgorithm("BOGUS"),
+					Type:           Float32VectorType,
+					Dim:            4,
+					DistanceMetric: L2Metric,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "vector field with zero dim",
+			field: Field{
+				Name: "vec",
+				Type: VectorField,
+				Options: VectorFieldOptions{
+					Algorithm:      FlatVectorAlgorithm,
+					Type:           Float32VectorType,
+					DistanceMetric: L2Metric,
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.field.Serialize()
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Serialize() expected error, got none (args=%v)", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Serialize() unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Serialize() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchemaSerialize(t *testing.T) {
+	sc := NewSchema(Options{
+		On:            JSONDataType,
+		Prefix:        []string{"doc:"},
+		Filter:        "@lang:\"en\"",
+		Language:      "english",
+		LanguageField: "lang",
+		Score:         0.5,
+		ScoreField:    "score",
+		PayloadField:  "payload",
+		NoFieldFlags:  true,
+		NoFrequencies: true,
+		Stopwords:     []string{"a", "the"},
+	})
+	sc.AddField(NewTextField("title"))
+
+	got, err := sc.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize() unexpected error: %v", err)
+	}
+
+	want := []interface{}{
+		"ON", "JSON",
+		"PREFIX", 1, "doc:",
+		"FILTER", "@lang:\"en\"",
+		"LANGUAGE", "english",
+		"LANGUAGE_FIELD", "lang",
+		"SCORE", 0.5,
+		"SCORE_FIELD", "score",
+		"PAYLOAD_FIELD", "payload",
+		"NOFIELDS",
+		"NOFREQS",
+		"STOPWORDS", 2, "a", "the",
+		"SCHEMA",
+		"title", "TEXT",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Serialize() = %#v, want %#v", got, want)
+	}
+}
+
+func TestSchemaSerializeDefaultsToHash(t *testing.T) {
+	sc := NewSchema(DefaultOptions)
+	got, err := sc.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize() unexpected error: %v", err)
+	}
+	if got[0] != "ON" || got[1] != "HASH" {
+		t.Errorf("Serialize() = %#v, want ON HASH prefix", got)
+	}
+}